@@ -0,0 +1,160 @@
+/*
+
+
+island.go implementation of an island model for parallel NEAT populations.
+
+@licstart   The following is the entire license notice for
+the Go code in this page.
+
+Copyright (C) 2016 jin yeom, whitewolf.studio
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+As additional permission under GNU GPL version 3 section 7, you
+may distribute non-source (e.g., minimized or compacted) forms of
+that code without the copy of the GNU GPL normally required by
+section 4, provided you include this license notice and a URL
+through which recipients can access the Corresponding Source.
+
+@licend    The above is the entire license notice
+for the Go code in this page.
+
+
+*/
+
+package neat
+
+import (
+	"math/rand"
+	"sync"
+)
+
+// Island wraps a single, independently evolving population so that it can
+// be run alongside other islands and periodically exchange genomes with
+// them.
+type Island struct {
+	id  int
+	pop *Population
+}
+
+// NewIsland creates a new island given an ID and the population it owns.
+func NewIsland(id int, pop *Population) *Island {
+	return &Island{
+		id:  id,
+		pop: pop,
+	}
+}
+
+// ID returns this island's ID.
+func (i *Island) ID() int {
+	return i.id
+}
+
+// Population returns this island's population.
+func (i *Island) Population() *Population {
+	return i.pop
+}
+
+// Archipelago coordinates a set of islands, evolving them in parallel and,
+// after each generation with probability param.MigrationChance, migrating
+// genomes between them. This mirrors the
+// DemeGroup pattern used for distributed GA runs: each island explores its
+// own region of the search space, and migration keeps them from converging
+// to the same local optimum too early.
+type Archipelago struct {
+	islands    []*Island
+	generation int
+}
+
+// NewArchipelago creates a new archipelago of n islands, using newPop to
+// seed each island's population.
+func NewArchipelago(n int, newPop func(id int) *Population) *Archipelago {
+	islands := make([]*Island, n)
+	for i := 0; i < n; i++ {
+		islands[i] = NewIsland(i, newPop(i))
+	}
+	return &Archipelago{
+		islands:    islands,
+		generation: 0,
+	}
+}
+
+// Islands returns this archipelago's islands.
+func (a *Archipelago) Islands() []*Island {
+	return a.islands
+}
+
+// Evolve runs ngen generations of evolution, advancing every island's
+// population in its own goroutine and, once all islands have finished a
+// generation, making one migration attempt between them (see migrate).
+func (a *Archipelago) Evolve(ngen int) {
+	for g := 0; g < ngen; g++ {
+		var wg sync.WaitGroup
+		for _, island := range a.islands {
+			wg.Add(1)
+			go func(i *Island) {
+				defer wg.Done()
+				i.pop.Evolve()
+			}(island)
+		}
+		wg.Wait()
+
+		a.migrate()
+		a.generation++
+	}
+}
+
+// migrate swaps a small number of genomes between islands, replacing a
+// random member of a random species in the receiving island's population
+// with a clone of a champion from the sender, so island size stays
+// constant. Migration only happens with probability param.MigrationChance,
+// and only once there's more than one island to migrate between.
+//
+// The champion is cloned rather than moved: the original genome stays in
+// src's population, so src keeps its size, and when src later closes the
+// original via Species.Cull, dst's copy is not affected.
+func (a *Archipelago) migrate() {
+	if len(a.islands) < 2 || rand.Float64() > param.MigrationChance {
+		return
+	}
+
+	// Snapshot every island's champion before mutating any island: the
+	// ring assigns island i's champion to island i+1, so if we read
+	// champions lazily while applying swaps, an island that already
+	// received a migrant as dst would hand that just-inserted clone back
+	// out as its own champion the moment it's later visited as src.
+	champions := make([]*Genome, len(a.islands))
+	for i, island := range a.islands {
+		champions[i] = island.pop.Champion().Clone()
+	}
+
+	for i, champion := range champions {
+		dst := a.islands[(i+1)%len(a.islands)]
+
+		species := dst.pop.Species()
+		if len(species) == 0 {
+			continue
+		}
+		target := species[rand.Intn(len(species))]
+
+		members := target.Members()
+		if len(members) == 0 {
+			continue
+		}
+		evicted := members[rand.Intn(len(members))]
+		target.RemoveMember(evicted)
+		evicted.Close()
+		target.AddMember(champion)
+	}
+}
@@ -0,0 +1,89 @@
+/*
+
+
+pool.go implementation of a genome recycler backed by sync.Pool.
+
+@licstart   The following is the entire license notice for
+the Go code in this page.
+
+Copyright (C) 2016 jin yeom, whitewolf.studio
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+As additional permission under GNU GPL version 3 section 7, you
+may distribute non-source (e.g., minimized or compacted) forms of
+that code without the copy of the GNU GPL normally required by
+section 4, provided you include this license notice and a URL
+through which recipients can access the Corresponding Source.
+
+@licend    The above is the entire license notice
+for the Go code in this page.
+
+
+*/
+
+package neat
+
+import "sync"
+
+// genomePool recycles *Genome values (and their backing node/connection
+// slices) across generations: Species.Cull closes culled members into it
+// and Species.VarMembers draws its children from it via newChild.
+// Crossover itself still allocates the genome it returns, and newChild
+// replaces the recycled genome's slices with those of that result.
+var genomePool = sync.Pool{
+	New: func() interface{} {
+		return &Genome{}
+	},
+}
+
+// getGenome returns a recycled *Genome from the pool, or a freshly
+// allocated one if the pool is empty.
+func getGenome() *Genome {
+	return genomePool.Get().(*Genome)
+}
+
+// newChild crosses parent0 and parent1 over the given innovation number,
+// the same way Species.VarMembers always has, but returns the result in a
+// genome pulled from genomePool instead of Crossover's own fresh
+// allocation, so the reproduction path actually drains the pool that
+// Species.Cull feeds into.
+func newChild(parent0, parent1 *Genome, innovation int) *Genome {
+	produced := Crossover(parent0, parent1, innovation)
+
+	child := getGenome()
+	*child = *produced
+
+	// produced is about to be discarded back into the pool; clear its
+	// slice headers first so Close (and whoever getGenome later hands
+	// produced's backing struct to) doesn't alias the backing arrays
+	// child just took ownership of.
+	produced.nodes = nil
+	produced.conns = nil
+	produced.Close()
+
+	return child
+}
+
+// Close resets this genome and returns its backing slices to the pool,
+// for reuse by a future genome. The genome must not be used again after
+// Close is called.
+func (g *Genome) Close() {
+	g.gid = 0
+	g.sid = 0
+	g.fitness = 0.0
+	g.nodes = g.nodes[:0]
+	g.conns = g.conns[:0]
+	genomePool.Put(g)
+}
@@ -0,0 +1,53 @@
+package neat
+
+import "testing"
+
+// BenchmarkSpeciesCull measures allocation pressure from repeatedly
+// culling and regrowing a species' members, which is where the genome
+// pool introduced in pool.go is expected to pay off for large
+// populations.
+func BenchmarkSpeciesCull(b *testing.B) {
+	const popSize = 200
+
+	s := NewSpecies(0, getGenome())
+	for i := 0; i < popSize; i++ {
+		g := getGenome()
+		g.gid = i + 1
+		g.fitness = float64(i)
+		s.AddMember(g)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for len(s.members) < popSize {
+			g := getGenome()
+			g.gid = len(s.members) + 1
+			s.members = append(s.members, g)
+		}
+		s.Cull(popSize/2, true)
+	}
+}
+
+// BenchmarkSpeciesVarMembers exercises the actual reproduction path
+// (Species.VarMembers, which culls via the pool and reproduces children
+// via newChild) rather than just the cull half of the cycle, to make sure
+// the pool is actually drained in production use, not just in
+// BenchmarkSpeciesCull's synthetic loop.
+func BenchmarkSpeciesVarMembers(b *testing.B) {
+	const popSize = 200
+
+	s := NewSpecies(0, getGenome())
+	for i := 0; i < popSize; i++ {
+		g := getGenome()
+		g.gid = i + 1
+		g.fitness = float64(i)
+		s.AddMember(g)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.VarMembers()
+	}
+}
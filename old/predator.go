@@ -0,0 +1,111 @@
+/*
+
+
+predator.go implementation of pluggable culling strategies.
+
+@licstart   The following is the entire license notice for
+the Go code in this page.
+
+Copyright (C) 2016 jin yeom, whitewolf.studio
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+As additional permission under GNU GPL version 3 section 7, you
+may distribute non-source (e.g., minimized or compacted) forms of
+that code without the copy of the GNU GPL normally required by
+section 4, provided you include this license notice and a URL
+through which recipients can access the Corresponding Source.
+
+@licend    The above is the entire license notice
+for the Go code in this page.
+
+
+*/
+
+package neat
+
+import "sort"
+
+// Predator decides which genomes in a species die each generation, as
+// opposed to Selector, which decides which survivors become parents.
+// Species.Select previously conflated the two; a Predator lets "who dies"
+// be swapped independently, e.g. to fight premature convergence without
+// changing how parents are chosen for reproduction.
+type Predator interface {
+	Cull(species *Species, nkeep int, keepBest bool) []*Genome
+}
+
+// BestPredator keeps the nkeep fittest genomes, the species' original
+// culling behavior.
+type BestPredator struct{}
+
+// Cull implements the Predator interface.
+func (p BestPredator) Cull(species *Species, nkeep int, keepBest bool) []*Genome {
+	sort.Sort(byFitness(species.members))
+	if nkeep > len(species.members) {
+		nkeep = len(species.members)
+	}
+	return species.members[:nkeep]
+}
+
+// FussPredator uses Fixed Uniform Selection to decide who survives,
+// preserving diversity the same way FUSSSelector does for parent
+// selection, but applied to culling instead.
+type FussPredator struct {
+	FussLimit float64
+}
+
+// Cull implements the Predator interface.
+func (p FussPredator) Cull(species *Species, nkeep int, keepBest bool) []*Genome {
+	if nkeep > len(species.members) {
+		nkeep = len(species.members)
+	}
+
+	nbest := 0
+	if keepBest {
+		nbest = 1
+	}
+	selector := FUSSSelector{FussLimit: p.FussLimit, NBest: nbest}
+	return selector.Select(species.members, nkeep)
+}
+
+// AgeBasedPredator biases culling toward genomes belonging to long-
+// stagnant species: it keeps nkeep genomes as usual, but when a species
+// has been stagnant (per Species.IsStagnant) it skews the cut toward
+// removing more of that species' weaker members first, making room for
+// fresh genomes elsewhere in the population.
+type AgeBasedPredator struct {
+	StagnantAge int
+}
+
+// Cull implements the Predator interface.
+func (p AgeBasedPredator) Cull(species *Species, nkeep int, keepBest bool) []*Genome {
+	sort.Sort(byFitness(species.members))
+	if nkeep > len(species.members) {
+		nkeep = len(species.members)
+	}
+
+	if species.age < p.StagnantAge || !species.IsStagnant() {
+		return species.members[:nkeep]
+	}
+
+	// Stagnant species lose an extra member beyond the usual cut, unless
+	// that would remove the champion.
+	extra := 1
+	cut := nkeep - extra
+	if cut < 1 {
+		cut = 1
+	}
+	return species.members[:cut]
+}
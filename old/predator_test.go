@@ -0,0 +1,55 @@
+package neat
+
+import "testing"
+
+func predatorMembers(s *Species, fitnesses ...float64) {
+	for i, f := range fitnesses {
+		s.AddMember(&Genome{gid: i + 1, fitness: f})
+	}
+}
+
+func TestBestPredatorCull(t *testing.T) {
+	s := NewSpecies(0, nil)
+	predatorMembers(s, 3, 1, 2)
+
+	survived := BestPredator{}.Cull(s, 2, true)
+	if len(survived) != 2 {
+		t.Fatalf("Cull(s, 2, true) returned %d genomes, want 2", len(survived))
+	}
+	if survived[0].fitness != 3 {
+		t.Errorf("Cull(s, 2, true)[0].fitness = %v, want the fittest genome (3)", survived[0].fitness)
+	}
+}
+
+func TestBestPredatorCullClampsToMemberCount(t *testing.T) {
+	s := NewSpecies(0, nil)
+	predatorMembers(s, 3, 1)
+
+	survived := BestPredator{}.Cull(s, 10, true)
+	if len(survived) != 2 {
+		t.Fatalf("Cull(s, 10, true) returned %d genomes, want 2 (clamped to member count)", len(survived))
+	}
+}
+
+func TestFussPredatorCullClampsToMemberCount(t *testing.T) {
+	s := NewSpecies(0, nil)
+	predatorMembers(s, 3, 1, 2)
+
+	survived := FussPredator{FussLimit: 5}.Cull(s, 10, true)
+	if len(survived) != 3 {
+		t.Fatalf("Cull(s, 10, true) returned %d genomes, want 3 (clamped to member count)", len(survived))
+	}
+}
+
+func TestAgeBasedPredatorCullKeepsBestWhenYoung(t *testing.T) {
+	s := NewSpecies(0, nil)
+	predatorMembers(s, 3, 1, 2)
+
+	survived := AgeBasedPredator{StagnantAge: 5}.Cull(s, 2, true)
+	if len(survived) != 2 {
+		t.Fatalf("Cull(s, 2, true) on a young species returned %d genomes, want 2", len(survived))
+	}
+	if survived[0].fitness != 3 {
+		t.Errorf("Cull(s, 2, true)[0].fitness = %v, want the fittest genome (3)", survived[0].fitness)
+	}
+}
@@ -0,0 +1,173 @@
+/*
+
+
+selector.go implementation of pluggable parent selection strategies.
+
+@licstart   The following is the entire license notice for
+the Go code in this page.
+
+Copyright (C) 2016 jin yeom, whitewolf.studio
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+As additional permission under GNU GPL version 3 section 7, you
+may distribute non-source (e.g., minimized or compacted) forms of
+that code without the copy of the GNU GPL normally required by
+section 4, provided you include this license notice and a URL
+through which recipients can access the Corresponding Source.
+
+@licend    The above is the entire license notice
+for the Go code in this page.
+
+
+*/
+
+package neat
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// Selector chooses n genomes out of members to act as parents for
+// reproduction. It has no say over who survives culling (see Predator);
+// by default Species.Select passes it the full, already-culled member
+// list, so a Selector like ElitePoolSelector or FUSSSelector only narrows
+// down the breeding pool, not the species itself. Implementations are
+// free to sort or mutate the slice they're given; species.Select hands
+// them members in whatever order they've accumulated in.
+type Selector interface {
+	Select(members []*Genome, n int) []*Genome
+}
+
+// TruncationSelector sorts members by fitness and keeps the top n, i.e.
+// the species' original survival-rate behavior.
+type TruncationSelector struct{}
+
+// Select implements the Selector interface.
+func (s TruncationSelector) Select(members []*Genome, n int) []*Genome {
+	sort.Sort(byFitness(members))
+	if n > len(members) {
+		n = len(members)
+	}
+	return members[:n]
+}
+
+// TournamentSelector repeatedly samples TournamentSize members at random
+// and keeps the fittest of each sample, until n genomes have been chosen.
+type TournamentSelector struct {
+	TournamentSize int
+}
+
+// Select implements the Selector interface.
+func (s TournamentSelector) Select(members []*Genome, n int) []*Genome {
+	selected := make([]*Genome, 0, n)
+	for i := 0; i < n; i++ {
+		best := members[rand.Intn(len(members))]
+		for j := 1; j < s.TournamentSize; j++ {
+			candidate := members[rand.Intn(len(members))]
+			if toolbox.Comparison(candidate, best) == 1 {
+				best = candidate
+			}
+		}
+		selected = append(selected, best)
+	}
+	return selected
+}
+
+// ElitePoolSelector maintains a shared pool of the K fittest genomes seen
+// so far, replenishing it from each new set of members before selecting
+// from it. This lets a small set of elites act as parents across several
+// generations instead of being limited to the current species members.
+//
+// The pool holds its own clones, not the species' live *Genome pointers:
+// those live members are subject to Species.Cull closing them and handing
+// their backing memory back out via genomePool, which would otherwise
+// leave the pool holding a stale or repurposed genome.
+type ElitePoolSelector struct {
+	K    int
+	pool []*Genome
+}
+
+// Select implements the Selector interface.
+func (s *ElitePoolSelector) Select(members []*Genome, n int) []*Genome {
+	for _, g := range members {
+		s.pool = append(s.pool, g.Clone())
+	}
+	sort.Sort(byFitness(s.pool))
+	if len(s.pool) > s.K {
+		for _, g := range s.pool[s.K:] {
+			g.Close()
+		}
+		s.pool = s.pool[:s.K]
+	}
+
+	selected := make([]*Genome, n)
+	for i := 0; i < n; i++ {
+		selected[i] = s.pool[i%len(s.pool)]
+	}
+	return selected
+}
+
+// FUSSSelector implements the Fixed Uniform Selection Scheme (FUSS):
+// a target fitness t is drawn uniformly from [fmin, fmin+FussLimit] (or
+// [fmin, fmax] if the fitness range doesn't exceed FussLimit), and the
+// genome whose fitness is closest to t is selected. This keeps a few
+// super-fit genomes from dominating selection, which matters in NEAT
+// because structural innovations need time to be optimized before they
+// can compete on fitness alone. The NBest fittest genomes are always
+// retained first and count toward the n returned; FUSS fills the rest.
+type FUSSSelector struct {
+	FussLimit float64
+	NBest     int
+}
+
+// Select implements the Selector interface.
+func (s FUSSSelector) Select(members []*Genome, n int) []*Genome {
+	if n > len(members) {
+		n = len(members)
+	}
+
+	sort.Sort(byFitness(members))
+
+	fmin, fmax := members[len(members)-1].fitness, members[0].fitness
+	nbest := s.NBest
+	if nbest > n {
+		nbest = n
+	}
+
+	selected := make([]*Genome, 0, n)
+	selected = append(selected, members[:nbest]...)
+
+	for len(selected) < n {
+		var t float64
+		if fmax-fmin > s.FussLimit {
+			t = fmin + rand.Float64()*s.FussLimit
+		} else {
+			t = fmin + rand.Float64()*(fmax-fmin)
+		}
+
+		closest := members[0]
+		closestDist := math.Abs(closest.fitness - t)
+		for _, g := range members {
+			if d := math.Abs(g.fitness - t); d < closestDist {
+				closest, closestDist = g, d
+			}
+		}
+		selected = append(selected, closest)
+	}
+
+	return selected
+}
@@ -0,0 +1,48 @@
+package neat
+
+import "testing"
+
+func fussMembers(fitnesses ...float64) []*Genome {
+	members := make([]*Genome, len(fitnesses))
+	for i, f := range fitnesses {
+		members[i] = &Genome{gid: i + 1, fitness: f}
+	}
+	return members
+}
+
+func TestFUSSSelectorSelect(t *testing.T) {
+	members := fussMembers(0, 1, 2, 3, 10)
+	selector := FUSSSelector{FussLimit: 5, NBest: 1}
+
+	selected := selector.Select(members, 3)
+	if len(selected) != 3 {
+		t.Fatalf("Select(members, 3) returned %d genomes, want 3", len(selected))
+	}
+
+	if selected[0].fitness != 10 {
+		t.Errorf("Select's NBest=1 genome has fitness %v, want the fittest (10)", selected[0].fitness)
+	}
+}
+
+func TestFUSSSelectorSelectClampsToMemberCount(t *testing.T) {
+	members := fussMembers(0, 1, 2)
+	selector := FUSSSelector{FussLimit: 5}
+
+	selected := selector.Select(members, len(members)+10)
+	if len(selected) != len(members) {
+		t.Fatalf("Select(members, n > len(members)) returned %d genomes, want %d", len(selected), len(members))
+	}
+}
+
+func TestTruncationSelectorSelect(t *testing.T) {
+	members := fussMembers(3, 1, 2)
+	selector := TruncationSelector{}
+
+	selected := selector.Select(members, 2)
+	if len(selected) != 2 {
+		t.Fatalf("Select(members, 2) returned %d genomes, want 2", len(selected))
+	}
+	if selected[0].fitness != 3 || selected[1].fitness != 2 {
+		t.Errorf("Select(members, 2) = %v, want the two fittest genomes in descending order", selected)
+	}
+}
@@ -0,0 +1,135 @@
+/*
+
+
+soma.go implementation of a SOMA T3A weight refinement pass.
+
+@licstart   The following is the entire license notice for
+the Go code in this page.
+
+Copyright (C) 2016 jin yeom, whitewolf.studio
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+As additional permission under GNU GPL version 3 section 7, you
+may distribute non-source (e.g., minimized or compacted) forms of
+that code without the copy of the GNU GPL normally required by
+section 4, provided you include this license notice and a URL
+through which recipients can access the Corresponding Source.
+
+@licend    The above is the entire license notice
+for the Go code in this page.
+
+
+*/
+
+package neat
+
+import (
+	"math/rand"
+	"sort"
+)
+
+// SOMAParam holds the parameters of the SOMA T3A (Self-Organizing
+// Migrating Algorithm, strategy T3A) weight refinement pass: the K fittest
+// genomes of a species are the migrating individuals, and each takes
+// Njumps discrete jumps toward each of the M fittest genomes (the leaders),
+// perturbing a random subset of weight dimensions on each jump. Step
+// controls the jump size relative to the distance to the leader. N is not
+// read by RefineWeights.
+type SOMAParam struct {
+	N      int
+	M      int
+	K      int
+	Njumps int
+	Step   float64
+}
+
+// prtVector draws a perturbation vector of length dim, where each
+// dimension is included (set to 1) independently with probability prt.
+// prt grows from 0.05 to 0.95 as the search progresses, so early jumps
+// perturb few dimensions and late jumps perturb most of them.
+func prtVector(dim int, prt float64) []float64 {
+	v := make([]float64, dim)
+	for i := range v {
+		if rand.Float64() < prt {
+			v[i] = 1.0
+		}
+	}
+	return v
+}
+
+// RefineWeights runs a SOMA T3A local-search pass over the connection
+// weights of this species' K fittest genomes, giving NEAT's topological
+// search a memetic fine-tuning step. It's meant to run between
+// FitnessShare and VarMembers, so it refines weights before they're
+// weighed for selection and reproduction.
+//
+// fes and maxFEs are the number of function evaluations spent so far and
+// the evaluation budget for the whole run; they control how aggressively
+// SOMA perturbs weights via the PRT parameter.
+func (s *Species) RefineWeights(fes, maxFEs int) {
+	p := param.SOMA
+	prt := 0.05 + 0.90*(float64(fes)/float64(maxFEs))
+
+	sort.Sort(byFitness(s.members))
+	leaders := s.members
+	if len(leaders) > p.M {
+		leaders = leaders[:p.M]
+	}
+
+	migrating := s.members
+	if len(migrating) > p.K {
+		migrating = migrating[:p.K]
+	}
+
+	for _, individual := range migrating {
+		best := individual
+		bestWeights := individual.Weights()
+
+		for _, leader := range leaders {
+			if leader == individual {
+				continue
+			}
+
+			pos := individual.Weights()
+			leaderWeights := leader.Weights()
+			dim := len(pos)
+
+			for jump := 0; jump < p.Njumps; jump++ {
+				prtv := prtVector(dim, prt)
+				step := p.Step * float64(jump+1) / float64(p.Njumps)
+
+				next := make([]float64, dim)
+				for i := range next {
+					next[i] = pos[i]
+					if prtv[i] == 1.0 {
+						next[i] += step * (leaderWeights[i] - pos[i])
+					}
+				}
+				pos = next
+
+				candidate := individual.Clone()
+				candidate.SetWeights(pos)
+				candidate.fitness = toolbox.Evaluate(candidate)
+				if toolbox.Comparison(candidate, best) == 1 {
+					best = candidate
+					bestWeights = pos
+				}
+			}
+		}
+
+		individual.SetWeights(bestWeights)
+		individual.fitness = best.fitness
+	}
+}
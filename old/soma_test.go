@@ -0,0 +1,51 @@
+package neat
+
+import "testing"
+
+func TestPRTVectorRespectsDimension(t *testing.T) {
+	v := prtVector(8, 0.5)
+	if len(v) != 8 {
+		t.Fatalf("prtVector(8, 0.5) has length %d, want 8", len(v))
+	}
+	for i, bit := range v {
+		if bit != 0 && bit != 1 {
+			t.Errorf("prtVector(8, 0.5)[%d] = %v, want 0 or 1", i, bit)
+		}
+	}
+}
+
+func TestPRTVectorProbabilityBounds(t *testing.T) {
+	if v := prtVector(100, 0); sum(v) != 0 {
+		t.Errorf("prtVector(100, 0) perturbed %v dimensions, want none", sum(v))
+	}
+	if v := prtVector(100, 1); sum(v) != 100 {
+		t.Errorf("prtVector(100, 1) perturbed %v/100 dimensions, want all", sum(v))
+	}
+}
+
+func sum(v []float64) float64 {
+	total := 0.0
+	for _, x := range v {
+		total += x
+	}
+	return total
+}
+
+func TestRefineWeightsKeepsTheBestWeightsFound(t *testing.T) {
+	oldParam := param
+	param.SOMA = SOMAParam{N: 1, M: 1, K: 2, Njumps: 3, Step: 1.0}
+	defer func() { param = oldParam }()
+
+	leader := &Genome{gid: 1, fitness: 10}
+	migrant := &Genome{gid: 2, fitness: 1}
+
+	s := NewSpecies(0, leader)
+	s.AddMember(leader)
+	s.AddMember(migrant)
+
+	s.RefineWeights(0, 100)
+
+	if migrant.fitness < 1 {
+		t.Errorf("RefineWeights left migrant.fitness at %v, want it to only ever improve", migrant.fitness)
+	}
+}
@@ -38,7 +38,6 @@ package neat
 import (
 	"math"
 	"math/rand"
-	"sort"
 )
 
 // Species is an implementation of species of genomes in NEAT, which
@@ -50,6 +49,8 @@ type Species struct {
 	prevFitness    float64   // previous average fitness
 	representative *Genome   // species representative
 	members        []*Genome // genomes in this species
+	selector       Selector  // parent selection strategy
+	predator       Predator  // culling strategy
 }
 
 // NewSpecies creates a new species given a species ID, and the genome
@@ -61,9 +62,24 @@ func NewSpecies(sid int, g *Genome) *Species {
 		prevFitness:    0.0,
 		representative: g,
 		members:        []*Genome{},
+		selector:       TruncationSelector{},
+		predator:       BestPredator{},
 	}
 }
 
+// SetSelector replaces this species' parent selection strategy; the
+// default is TruncationSelector, matching the original survival-rate
+// behavior.
+func (s *Species) SetSelector(selector Selector) {
+	s.selector = selector
+}
+
+// SetPredator replaces this species' culling strategy; the default is
+// BestPredator, which keeps the fittest genomes.
+func (s *Species) SetPredator(predator Predator) {
+	s.predator = predator
+}
+
 // SID returns this species' species ID.
 func (s *Species) SID() int {
 	return s.sid
@@ -90,15 +106,44 @@ func (s *Species) AddMember(g *Genome) {
 	s.members = append(s.members, g)
 }
 
-// Select sorts the members by their fitness values and update them based on
-// the survival rate; return the remaining members.
-func (s *Species) Select() []*Genome {
-	sort.Sort(byFitness(s.members))
-	survived := int(math.Ceil(float64(len(s.members)) * param.SurvivalRate))
-	s.members = s.members[:survived]
+// RemoveMember removes g from this species' members, if present.
+func (s *Species) RemoveMember(g *Genome) {
+	for i, m := range s.members {
+		if m == g {
+			s.members = append(s.members[:i], s.members[i+1:]...)
+			return
+		}
+	}
+}
+
+// Cull applies this species' predator to narrow members down to nkeep,
+// deciding who dies independently of who gets to reproduce; updates the
+// members accordingly and returns the survivors. Culled members are
+// closed and returned to the genome pool.
+func (s *Species) Cull(nkeep int, keepBest bool) []*Genome {
+	survived := s.predator.Cull(s, nkeep, keepBest)
+
+	keep := make(map[*Genome]bool, len(survived))
+	for _, g := range survived {
+		keep[g] = true
+	}
+	for _, g := range s.members {
+		if !keep[g] {
+			g.Close()
+		}
+	}
+
+	s.members = survived
 	return s.members
 }
 
+// Select applies this species' selector to choose parent genomes for
+// reproduction out of the current members. Unlike Cull, Select doesn't
+// remove anyone from the species; it just narrows down who breeds.
+func (s *Species) Select() []*Genome {
+	return s.selector.Select(s.members, len(s.members))
+}
+
 // Champion returns the genome with the best fitness value in this species.
 func (s *Species) Champion() *Genome {
 	champion := s.members[0]
@@ -160,19 +205,23 @@ func (s *Species) FitnessShare() {
 	}
 }
 
-// VarMembers selects n parent genomes and reproduce len(species) - n
-// number of children genomes; n is determined by survival rate from
-// parameter. Update the members.
+// VarMembers culls the species down to a survival-rate-determined number
+// of members, selects parent genomes from the survivors, and reproduces
+// enough children to bring the species back up to its original size.
+// Update the members.
 func (s *Species) VarMembers() {
 	numMembers := len(s.members)
-	survived := s.Select()
-	numSurvived := len(survived)
+	nkeep := int(math.Ceil(float64(numMembers) * param.SurvivalRate))
+	survived := s.Cull(nkeep, true)
+
+	parents := s.Select()
+	numParents := len(parents)
 
-	numChildren := numMembers - numSurvived
+	numChildren := numMembers - len(survived)
 	for i := 0; i < numChildren; i++ {
-		parent0 := survived[rand.Intn(numSurvived)]
-		parent1 := survived[rand.Intn(numSurvived)]
-		child := Crossover(parent0, parent1, 0)
+		parent0 := parents[rand.Intn(numParents)]
+		parent1 := parents[rand.Intn(numParents)]
+		child := newChild(parent0, parent1, 0)
 		survived = append(survived, child)
 	}
 